@@ -5,17 +5,20 @@ package rds
 
 import (
 	"context"
+	"fmt"
+	"sync"
+	"time"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/rds"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
-	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"golang.org/x/sync/singleflight"
 )
 
 // NOTE ON "ID", "IDENTIFIER":
@@ -29,124 +32,277 @@ import (
 //    - can be updated
 //    - called "identifier" in the schema/state (previously was also "id")
 
-// findDBInstanceByIDSDKv1 in general should be called with a DbiResourceId of the form
-// "db-BE6UI2KLPQP3OVDYD74ZEV6NUM" rather than a DB identifier. However, in some cases only
-// the identifier is available, and can be used.
-func findDBInstanceByIDSDKv1(ctx context.Context, conn *rds.RDS, id string) (*rds.DBInstance, error) {
-	idLooksLikeDbiResourceId := regexache.MustCompile(`^db-[0-9A-Za-z]{2,255}$`).MatchString(id)
-	input := &rds.DescribeDBInstancesInput{}
+var dbInstanceResourceIDPattern = regexache.MustCompile(`^db-[0-9A-Za-z]{2,255}$`)
+
+// dbInstanceFinderCacheTTL bounds how long a DBInstanceFinder will serve a
+// DescribeDBInstances result from cache instead of calling the API again. It
+// is intentionally short: just long enough to collapse the handful of reads
+// (resource + any data sources) that typically happen within one Terraform
+// apply or refresh for the same DB instance.
+const dbInstanceFinderCacheTTL = 10 * time.Second
+
+// dbInstancesDescriber is the subset of *rds_sdkv2.Client DBInstanceFinder
+// depends on, so tests can exercise its caching/coalescing behavior against
+// a fake instead of a live RDS client.
+type dbInstancesDescriber interface {
+	DescribeDBInstances(ctx context.Context, input *rds_sdkv2.DescribeDBInstancesInput, optFns ...func(*rds_sdkv2.Options)) (*rds_sdkv2.DescribeDBInstancesOutput, error)
+}
+
+// DBInstanceFinder resolves RDS DB instances via the SDKv2 client. Lookups
+// for the same key that happen concurrently (e.g. a resource's Read and a
+// data source reading the same instance during the same apply) are
+// coalesced into a single DescribeDBInstances call, and results are cached
+// briefly so a burst of lookups for the same instance only hits the API
+// once.
+type DBInstanceFinder struct {
+	conn dbInstancesDescriber
+
+	group singleflight.Group
+
+	mu    sync.Mutex
+	cache map[string]dbInstanceCacheEntry
+}
+
+type dbInstanceCacheEntry struct {
+	instance *types.DBInstance
+	err      error
+	expires  time.Time
+}
+
+// NewDBInstanceFinder returns a DBInstanceFinder backed by conn.
+func NewDBInstanceFinder(conn dbInstancesDescriber) *DBInstanceFinder {
+	return &DBInstanceFinder{
+		conn:  conn,
+		cache: make(map[string]dbInstanceCacheEntry),
+	}
+}
+
+// ByID looks up a DB instance given either a dbi-resource-id (of the form
+// "db-BE6UI2KLPQP3OVDYD74ZEV6NUM") or a user-defined identifier. Because an
+// identifier can itself happen to look like a resource ID, a resource-ID
+// lookup that comes back empty is retried as an identifier lookup.
+func (f *DBInstanceFinder) ByID(ctx context.Context, id string) (*types.DBInstance, error) {
+	if dbInstanceResourceIDPattern.MatchString(id) {
+		output, err := f.ByResourceID(ctx, id)
+
+		if !tfresource.NotFound(err) {
+			return output, err
+		}
+	}
+
+	return f.ByIdentifier(ctx, id)
+}
 
-	if idLooksLikeDbiResourceId {
-		input.Filters = []*rds.Filter{
+// ByIdentifier looks up a DB instance by its user-defined identifier.
+func (f *DBInstanceFinder) ByIdentifier(ctx context.Context, identifier string) (*types.DBInstance, error) {
+	return f.find(ctx, "identifier:"+identifier, &rds_sdkv2.DescribeDBInstancesInput{
+		DBInstanceIdentifier: aws.String(identifier),
+	})
+}
+
+// ByResourceID looks up a DB instance by its immutable dbi-resource-id.
+func (f *DBInstanceFinder) ByResourceID(ctx context.Context, resourceID string) (*types.DBInstance, error) {
+	return f.find(ctx, "resource-id:"+resourceID, &rds_sdkv2.DescribeDBInstancesInput{
+		Filters: []types.Filter{
 			{
 				Name:   aws.String("dbi-resource-id"),
-				Values: aws.StringSlice([]string{id}),
+				Values: []string{resourceID},
 			},
-		}
-	} else {
-		input.DBInstanceIdentifier = aws.String(id)
+		},
+	})
+}
+
+// ByARN looks up a DB instance by its full RDS ARN.
+func (f *DBInstanceFinder) ByARN(ctx context.Context, arn string) (*types.DBInstance, error) {
+	return f.find(ctx, "arn:"+arn, &rds_sdkv2.DescribeDBInstancesInput{
+		DBInstanceIdentifier: aws.String(arn),
+	})
+}
+
+func (f *DBInstanceFinder) find(ctx context.Context, key string, input *rds_sdkv2.DescribeDBInstancesInput) (*types.DBInstance, error) {
+	if entry, ok := f.fromCache(key); ok {
+		return entry.instance, entry.err
 	}
 
-	output, err := findDBInstanceSDKv1(ctx, conn, input, tfslices.PredicateTrue[*rds.DBInstance]())
+	v, err, _ := f.group.Do(key, func() (any, error) {
+		output, err := f.conn.DescribeDBInstances(ctx, input)
 
-	// in case a DB has an *identifier* starting with "db-""
-	if idLooksLikeDbiResourceId && tfresource.NotFound(err) {
-		input := &rds.DescribeDBInstancesInput{
-			DBInstanceIdentifier: aws.String(id),
+		if errs.IsA[*types.DBInstanceNotFoundFault](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
 		}
 
-		output, err = findDBInstanceSDKv1(ctx, conn, input, tfslices.PredicateTrue[*rds.DBInstance]())
-	}
+		if err != nil {
+			return nil, err
+		}
 
-	if err != nil {
+		if output == nil {
+			return nil, tfresource.NewEmptyResultError(input)
+		}
+
+		instance, err := tfresource.AssertSingleValueResult(output.DBInstances)
+
+		f.store(instance)
+
+		return instance, err
+	})
+
+	if v == nil {
+		// Only cache a genuine NotFound: other errors (throttling, 5xx,
+		// network blips) are transient, and caching them would poison every
+		// other caller sharing this key for the rest of the TTL instead of
+		// just failing the one lookup that hit them.
+		if tfresource.NotFound(err) {
+			f.storeCache(key, nil, err)
+		}
 		return nil, err
 	}
 
-	return output, nil
+	// store() above only caches the instance under its resource-id/identifier
+	// keys, which doesn't cover every key a caller can look up by (e.g. an
+	// ARN). Cache it under this call's own key too so a repeat lookup by the
+	// same key is always served from cache.
+	instance := v.(*types.DBInstance)
+	f.storeCache(key, instance, nil)
+
+	return instance, err
 }
 
-func findDBInstanceSDKv1(ctx context.Context, conn *rds.RDS, input *rds.DescribeDBInstancesInput, filter tfslices.Predicate[*rds.DBInstance]) (*rds.DBInstance, error) {
-	output, err := findDBInstancesSDKv1(ctx, conn, input, filter)
+// store caches instance under both of its natural keys so a subsequent
+// ByResourceID or ByIdentifier lookup for the same instance is served from
+// cache, regardless of which key the caller used originally.
+func (f *DBInstanceFinder) store(instance *types.DBInstance) {
+	if instance == nil {
+		return
+	}
 
-	if err != nil {
-		return nil, err
+	if v := aws.ToString(instance.DbiResourceId); v != "" {
+		f.storeCache("resource-id:"+v, instance, nil)
 	}
 
-	return tfresource.AssertSinglePtrResult(output)
+	if v := aws.ToString(instance.DBInstanceIdentifier); v != "" {
+		f.storeCache("identifier:"+v, instance, nil)
+	}
 }
 
-func findDBInstancesSDKv1(ctx context.Context, conn *rds.RDS, input *rds.DescribeDBInstancesInput, filter tfslices.Predicate[*rds.DBInstance]) ([]*rds.DBInstance, error) {
-	var output []*rds.DBInstance
-
-	err := conn.DescribeDBInstancesPagesWithContext(ctx, input, func(page *rds.DescribeDBInstancesOutput, lastPage bool) bool {
-		if page == nil {
-			return !lastPage
-		}
+func (f *DBInstanceFinder) storeCache(key string, instance *types.DBInstance, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
 
-		for _, v := range page.DBInstances {
-			if v != nil && filter(v) {
-				output = append(output, v)
-			}
-		}
+	f.cache[key] = dbInstanceCacheEntry{
+		instance: instance,
+		err:      err,
+		expires:  time.Now().Add(dbInstanceFinderCacheTTL),
+	}
+}
 
-		return !lastPage
-	})
+func (f *DBInstanceFinder) fromCache(key string) (dbInstanceCacheEntry, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
 
-	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceNotFoundFault) {
-		return nil, &retry.NotFoundError{
-			LastError:   err,
-			LastRequest: input,
-		}
+	entry, ok := f.cache[key]
+	if !ok || time.Now().After(entry.expires) {
+		return dbInstanceCacheEntry{}, false
 	}
 
-	if err != nil {
-		return nil, err
+	return entry, true
+}
+
+// dbInstanceFinders holds one DBInstanceFinder per RDS client so that
+// unrelated callers sharing a connection also share its coalescing cache.
+var dbInstanceFinders sync.Map // map[*rds_sdkv2.Client]*DBInstanceFinder
+
+func dbInstanceFinderFor(conn *rds_sdkv2.Client) *DBInstanceFinder {
+	if v, ok := dbInstanceFinders.Load(conn); ok {
+		return v.(*DBInstanceFinder)
 	}
 
-	return output, nil
+	actual, _ := dbInstanceFinders.LoadOrStore(conn, NewDBInstanceFinder(conn))
+
+	return actual.(*DBInstanceFinder)
 }
 
 // findDBInstanceByIDSDKv2 in general should be called with a DbiResourceId of the form
 // "db-BE6UI2KLPQP3OVDYD74ZEV6NUM" rather than a DB identifier. However, in some cases only
 // the identifier is available, and can be used.
 func findDBInstanceByIDSDKv2(ctx context.Context, conn *rds_sdkv2.Client, id string) (*types.DBInstance, error) {
-	input := &rds_sdkv2.DescribeDBInstancesInput{}
+	return dbInstanceFinderFor(conn).ByID(ctx, id)
+}
 
-	if regexache.MustCompile(`^db-[0-9A-Za-z]{2,255}$`).MatchString(id) {
-		input.Filters = []types.Filter{
-			{
-				Name:   aws.String("dbi-resource-id"),
-				Values: []string{id},
-			},
+// findDBInstanceByAnyID resolves a DB instance from whatever form an import
+// ID happens to take: a raw dbi-resource-id, a plain identifier, or a full
+// RDS ARN. An ARN can name an instance in another region -- notably the
+// source of a replicated automated backup -- so a successful ARN-based
+// lookup switches the RDS client to that region first. It returns the
+// instance together with the region the successful lookup actually used, so
+// the importer can tell whether the client's configured region still
+// applies.
+func findDBInstanceByAnyID(ctx context.Context, client *conns.AWSClient, id string) (*types.DBInstance, string, error) {
+	region := client.Region
+
+	if dbInstanceResourceIDPattern.MatchString(id) {
+		output, err := dbInstanceFinderFor(client.RDSClient(ctx)).ByResourceID(ctx, id)
+
+		if !tfresource.NotFound(err) {
+			return output, region, err
 		}
-	} else {
-		input.DBInstanceIdentifier = aws.String(id)
 	}
 
-	output, err := conn.DescribeDBInstances(ctx, input)
+	if parsedARN, parseErr := arn.Parse(id); parseErr == nil {
+		region = parsedARN.Region
 
-	// in case a DB has an *identifier* starting with "db-""
-	if regexache.MustCompile(`^db-[0-9A-Za-z]{2,255}$`).MatchString(id) && (output == nil || len(output.DBInstances) == 0) {
-		input = &rds_sdkv2.DescribeDBInstancesInput{
-			DBInstanceIdentifier: aws.String(id),
-		}
-		output, err = conn.DescribeDBInstances(ctx, input)
+		output, err := dbInstanceFinderFor(client.RDSClient(ctx, func(o *rds_sdkv2.Options) { o.Region = region })).ByARN(ctx, id)
+
+		return output, region, err
 	}
 
-	if errs.IsA[*types.DBInstanceNotFoundFault](err) {
-		return nil, &retry.NotFoundError{
-			LastError:   err,
-			LastRequest: input,
-		}
+	output, err := dbInstanceFinderFor(client.RDSClient(ctx)).ByIdentifier(ctx, id)
+
+	return output, region, err
+}
+
+// waitDBInstanceDeleted waits for a DB instance to disappear, which is what
+// DeleteDBInstance means by "deleted" -- there is no terminal status to poll
+// for, only the instance's absence. Callers that set skip_final_snapshot=false
+// must wait through "backing-up" before the delete itself is even accepted,
+// so the full creating/backing-up/modifying/deleting/available cycle is
+// treated as pending.
+//
+// Used by aws_db_instance's own delete so that `terraform destroy` reliably
+// waits out a final snapshot rather than returning while the instance is
+// still "backing-up".
+func waitDBInstanceDeleted(ctx context.Context, conn *rds_sdkv2.Client, id string, timeout time.Duration) (*types.DBInstance, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			dbInstanceStatusAvailable,
+			dbInstanceStatusBackingUp,
+			dbInstanceStatusConfiguringLogExp,
+			dbInstanceStatusCreating,
+			dbInstanceStatusDeleting,
+			dbInstanceStatusModifying,
+			dbInstanceStatusStarting,
+			dbInstanceStatusStopping,
+			dbInstanceStatusStopped,
+			dbInstanceStatusUpgrading,
+		},
+		Target:  []string{},
+		Refresh: statusDBInstance(ctx, conn, id),
+		Timeout: timeout,
 	}
 
-	if err != nil {
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	output, ok := outputRaw.(*types.DBInstance)
+	if !ok {
 		return nil, err
 	}
 
-	if output == nil {
-		return nil, tfresource.NewEmptyResultError(input)
+	if err != nil {
+		err = tfresource.SetLastError(err, fmt.Errorf("last status (%s), pending modified values (%+v)",
+			aws.ToString(output.DBInstanceStatus), output.PendingModifiedValues))
 	}
 
-	return tfresource.AssertSingleValueResult(output.DBInstances)
+	return output, err
 }
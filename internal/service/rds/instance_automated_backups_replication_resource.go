@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_db_instance_automated_backups_replication", name="DB Instance Automated Backups Replication")
+func ResourceInstanceAutomatedBackupsReplication() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInstanceAutomatedBackupsReplicationCreate,
+		DeleteWithoutTimeout: resourceInstanceAutomatedBackupsReplicationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(75 * time.Minute),
+			Delete: schema.DefaultTimeout(75 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"source_db_instance_arn": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"pre_signed_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"retention_period": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  7,
+			},
+		},
+	}
+}
+
+func resourceInstanceAutomatedBackupsReplicationCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	sourceARN := d.Get("source_db_instance_arn").(string)
+
+	input := &rds_sdkv2.StartDBInstanceAutomatedBackupsReplicationInput{
+		SourceDBInstanceArn:   aws.String(sourceARN),
+		BackupRetentionPeriod: aws.Int32(int32(d.Get("retention_period").(int))),
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("pre_signed_url"); ok {
+		input.PreSignedUrl = aws.String(v.(string))
+	}
+
+	_, err := conn.StartDBInstanceAutomatedBackupsReplication(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting RDS DB Instance (%s) Automated Backups Replication: %s", sourceARN, err)
+	}
+
+	d.SetId(sourceARN)
+
+	if _, err := waitDBInstanceAvailable(ctx, conn, sourceARN, dbInstanceCreationModeCreate, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS DB Instance (%s) Automated Backups Replication: %s", sourceARN, err)
+	}
+
+	return diags
+}
+
+func resourceInstanceAutomatedBackupsReplicationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	_, err := conn.StopDBInstanceAutomatedBackupsReplication(ctx, &rds_sdkv2.StopDBInstanceAutomatedBackupsReplicationInput{
+		SourceDBInstanceArn: aws.String(d.Id()),
+	})
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "stopping RDS DB Instance (%s) Automated Backups Replication: %s", d.Id(), err)
+	}
+
+	// Stopping replication puts the source instance through "modifying"
+	// before it settles back to "available".
+	if _, err := waitDBInstanceAvailable(ctx, conn, d.Id(), dbInstanceCreationModeCreate, d.Timeout(schema.TimeoutDelete)); err != nil && !tfresource.NotFound(err) {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS DB Instance (%s) Automated Backups Replication stop: %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_db_instance_role_association", name="DB Instance Role Association")
+func ResourceInstanceRoleAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInstanceRoleAssociationCreate,
+		DeleteWithoutTimeout: resourceInstanceRoleAssociationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"db_instance_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"feature_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role_arn": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceInstanceRoleAssociationCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	dbInstanceIdentifier := d.Get("db_instance_identifier").(string)
+	roleARN := d.Get("role_arn").(string)
+
+	_, err := conn.AddRoleToDBInstance(ctx, &rds_sdkv2.AddRoleToDBInstanceInput{
+		DBInstanceIdentifier: aws.String(dbInstanceIdentifier),
+		FeatureName:          aws.String(d.Get("feature_name").(string)),
+		RoleArn:              aws.String(roleARN),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RDS DB Instance (%s) Role (%s) Association: %s", dbInstanceIdentifier, roleARN, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", dbInstanceIdentifier, roleARN))
+
+	return diags
+}
+
+func resourceInstanceRoleAssociationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	dbInstanceIdentifier, roleARN, err := instanceRoleAssociationParseID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting RDS DB Instance Role Association (%s): %s", d.Id(), err)
+	}
+
+	_, err = conn.RemoveRoleFromDBInstance(ctx, &rds_sdkv2.RemoveRoleFromDBInstanceInput{
+		DBInstanceIdentifier: aws.String(dbInstanceIdentifier),
+		FeatureName:          aws.String(d.Get("feature_name").(string)),
+		RoleArn:              aws.String(roleARN),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting RDS DB Instance Role Association (%s): %s", d.Id(), err)
+	}
+
+	// Role removal puts the instance through "modifying" before it settles
+	// back to "available".
+	if _, err := waitDBInstanceAvailable(ctx, conn, dbInstanceIdentifier, dbInstanceCreationModeCreate, d.Timeout(schema.TimeoutDelete)); err != nil && !tfresource.NotFound(err) {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS DB Instance (%s) Role (%s) disassociation: %s", dbInstanceIdentifier, roleARN, err)
+	}
+
+	return diags
+}
+
+func instanceRoleAssociationParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ",", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%q), expected db-instance-identifier,role-arn", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+)
+
+// fakeDBInstancesDescriber is a dbInstancesDescriber that serves
+// DescribeDBInstances from an in-memory list of instances and counts calls,
+// optionally blocking until released so concurrent-call coalescing can be
+// exercised.
+type fakeDBInstancesDescriber struct {
+	instances []types.DBInstance
+
+	// err, when non-nil, is returned as-is from every call instead of doing
+	// a lookup -- used to simulate a transient API error (throttling, 5xx).
+	err error
+
+	mu    sync.Mutex
+	calls int
+
+	release chan struct{}
+}
+
+func (f *fakeDBInstancesDescriber) DescribeDBInstances(ctx context.Context, input *rds_sdkv2.DescribeDBInstancesInput, optFns ...func(*rds_sdkv2.Options)) (*rds_sdkv2.DescribeDBInstancesOutput, error) {
+	f.mu.Lock()
+	f.calls++
+	f.mu.Unlock()
+
+	if f.release != nil {
+		<-f.release
+	}
+
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	var want string
+	switch {
+	case len(input.Filters) > 0:
+		want = input.Filters[0].Values[0]
+	case input.DBInstanceIdentifier != nil:
+		want = aws.ToString(input.DBInstanceIdentifier)
+	}
+
+	for _, instance := range f.instances {
+		if len(input.Filters) > 0 {
+			if aws.ToString(instance.DbiResourceId) == want {
+				return &rds_sdkv2.DescribeDBInstancesOutput{DBInstances: []types.DBInstance{instance}}, nil
+			}
+			continue
+		}
+
+		if aws.ToString(instance.DBInstanceIdentifier) == want {
+			return &rds_sdkv2.DescribeDBInstancesOutput{DBInstances: []types.DBInstance{instance}}, nil
+		}
+	}
+
+	return nil, &types.DBInstanceNotFoundFault{}
+}
+
+func (f *fakeDBInstancesDescriber) callCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls
+}
+
+func TestDBInstanceFinder_ByID_ResourceIDThenIdentifierFallback(t *testing.T) {
+	t.Parallel()
+
+	fake := &fakeDBInstancesDescriber{
+		instances: []types.DBInstance{
+			{
+				DbiResourceId:        aws.String("db-ACTUALRESOURCEID"),
+				DBInstanceIdentifier: aws.String("db-LOOKSLIKEARESOURCEID"),
+			},
+		},
+	}
+	finder := NewDBInstanceFinder(fake)
+
+	// "db-LOOKSLIKEARESOURCEID" matches the resource-ID pattern (alphanumeric
+	// after the "db-" prefix), so ByID tries it as a resource ID first (no
+	// match), then falls back to treating it as an identifier, which does
+	// match.
+	got, err := finder.ByID(context.Background(), "db-LOOKSLIKEARESOURCEID")
+
+	if err != nil {
+		t.Fatalf("ByID() error = %v", err)
+	}
+
+	if aws.ToString(got.DbiResourceId) != "db-ACTUALRESOURCEID" {
+		t.Errorf("ByID() = %+v, want instance with DbiResourceId db-ACTUALRESOURCEID", got)
+	}
+
+	if got, want := fake.callCount(), 2; got != want {
+		t.Errorf("DescribeDBInstances call count = %d, want %d (resource-id attempt + identifier fallback)", got, want)
+	}
+}
+
+func TestDBInstanceFinder_CachesWithinTTL(t *testing.T) {
+	t.Parallel()
+
+	fake := &fakeDBInstancesDescriber{
+		instances: []types.DBInstance{
+			{DbiResourceId: aws.String("db-CACHED"), DBInstanceIdentifier: aws.String("my-instance")},
+		},
+	}
+	finder := NewDBInstanceFinder(fake)
+
+	if _, err := finder.ByResourceID(context.Background(), "db-CACHED"); err != nil {
+		t.Fatalf("ByResourceID() error = %v", err)
+	}
+
+	if _, err := finder.ByResourceID(context.Background(), "db-CACHED"); err != nil {
+		t.Fatalf("ByResourceID() (cached) error = %v", err)
+	}
+
+	if got, want := fake.callCount(), 1; got != want {
+		t.Errorf("DescribeDBInstances call count = %d, want %d (second call should be served from cache)", got, want)
+	}
+
+	// store() caches the instance under its identifier key too.
+	if _, err := finder.ByIdentifier(context.Background(), "my-instance"); err != nil {
+		t.Fatalf("ByIdentifier() error = %v", err)
+	}
+
+	if got, want := fake.callCount(), 1; got != want {
+		t.Errorf("DescribeDBInstances call count = %d, want %d (identifier lookup should also hit cache populated by ByResourceID)", got, want)
+	}
+}
+
+func TestDBInstanceFinder_ByARN(t *testing.T) {
+	t.Parallel()
+
+	const instanceARN = "arn:aws:rds:us-west-2:123456789012:db:my-instance"
+
+	fake := &fakeDBInstancesDescriber{
+		instances: []types.DBInstance{
+			{DbiResourceId: aws.String("db-ARNLOOKUP"), DBInstanceIdentifier: aws.String(instanceARN)},
+		},
+	}
+	finder := NewDBInstanceFinder(fake)
+
+	got, err := finder.ByARN(context.Background(), instanceARN)
+
+	if err != nil {
+		t.Fatalf("ByARN() error = %v", err)
+	}
+
+	if aws.ToString(got.DbiResourceId) != "db-ARNLOOKUP" {
+		t.Errorf("ByARN() = %+v, want instance with DbiResourceId db-ARNLOOKUP", got)
+	}
+
+	if _, err := finder.ByARN(context.Background(), instanceARN); err != nil {
+		t.Fatalf("ByARN() (cached) error = %v", err)
+	}
+
+	if got, want := fake.callCount(), 1; got != want {
+		t.Errorf("DescribeDBInstances call count = %d, want %d (second ByARN call for the same ARN should be served from cache)", got, want)
+	}
+}
+
+func TestDBInstanceFinder_DoesNotCacheTransientErrors(t *testing.T) {
+	t.Parallel()
+
+	fake := &fakeDBInstancesDescriber{
+		err: errors.New("ThrottlingException: rate exceeded"),
+	}
+	finder := NewDBInstanceFinder(fake)
+
+	if _, err := finder.ByResourceID(context.Background(), "db-TRANSIENT"); err == nil {
+		t.Fatal("ByResourceID() error = nil, want the simulated transient error")
+	}
+
+	if _, err := finder.ByResourceID(context.Background(), "db-TRANSIENT"); err == nil {
+		t.Fatal("ByResourceID() (second call) error = nil, want the simulated transient error")
+	}
+
+	// A genuine NotFound would be cached and served without a second API
+	// call; a transient error must not poison the cache the same way, so
+	// the second lookup should have made its own call.
+	if got, want := fake.callCount(), 2; got != want {
+		t.Errorf("DescribeDBInstances call count = %d, want %d (transient errors must not be cached)", got, want)
+	}
+}
+
+func TestDBInstanceFinder_CoalescesConcurrentLookups(t *testing.T) {
+	t.Parallel()
+
+	fake := &fakeDBInstancesDescriber{
+		instances: []types.DBInstance{
+			{DbiResourceId: aws.String("db-CONCURRENT"), DBInstanceIdentifier: aws.String("my-instance")},
+		},
+		release: make(chan struct{}),
+	}
+	finder := NewDBInstanceFinder(fake)
+
+	const n = 5
+	var wg sync.WaitGroup
+	var errCount int32
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := finder.ByResourceID(context.Background(), "db-CONCURRENT"); err != nil {
+				atomic.AddInt32(&errCount, 1)
+			}
+		}()
+	}
+
+	close(fake.release)
+	wg.Wait()
+
+	if errCount != 0 {
+		t.Errorf("got %d errors among %d concurrent ByResourceID() calls, want 0", errCount, n)
+	}
+
+	if got, want := fake.callCount(), 1; got != want {
+		t.Errorf("DescribeDBInstances call count = %d, want %d (concurrent calls for the same key should coalesce)", got, want)
+	}
+}
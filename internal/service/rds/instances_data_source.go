@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_db_instances", name="DB Instances")
+func DataSourceInstances() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"identifiers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceInstancesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	input := &rds_sdkv2.DescribeDBInstancesInput{
+		Filters: expandDBInstanceFilters(d.Get("filter").(*schema.Set)),
+	}
+
+	instances, err := findDBInstancesSDKv2(ctx, conn, input, tfslices.PredicateTrue[*types.DBInstance]())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RDS DB Instances: %s", err)
+	}
+
+	if tagFilter, ok := d.GetOk("tags"); ok {
+		instances, err = filterDBInstancesByTags(ctx, conn, instances, tagFilter.(map[string]any))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "filtering RDS DB Instances by tags: %s", err)
+		}
+	}
+
+	var identifiers, resourceIDs []string
+	arns := make(map[string]string, len(instances))
+
+	for _, instance := range instances {
+		identifier := aws.ToString(instance.DBInstanceIdentifier)
+		resourceID := aws.ToString(instance.DbiResourceId)
+
+		identifiers = append(identifiers, identifier)
+		resourceIDs = append(resourceIDs, resourceID)
+		arns[identifier] = aws.ToString(instance.DBInstanceArn)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("arns", arns)
+	d.Set("identifiers", identifiers)
+	d.Set("resource_ids", resourceIDs)
+
+	return diags
+}
+
+// expandDBInstanceFilters converts the "filter" blocks in config into the
+// []types.Filter shape DescribeDBInstances expects, e.g. "dbi-resource-id",
+// "db-instance-id", "engine", "db-cluster-id".
+func expandDBInstanceFilters(set *schema.Set) []types.Filter {
+	if set == nil || set.Len() == 0 {
+		return nil
+	}
+
+	filters := make([]types.Filter, 0, set.Len())
+
+	for _, v := range set.List() {
+		m := v.(map[string]any)
+
+		filters = append(filters, types.Filter{
+			Name:   aws.String(m["name"].(string)),
+			Values: tfslices.ApplyToAll(m["values"].([]any), func(v any) string { return v.(string) }),
+		})
+	}
+
+	return filters
+}
+
+// findDBInstancesSDKv2 returns every DB instance matching input, additionally
+// applying filter client-side.
+func findDBInstancesSDKv2(ctx context.Context, conn *rds_sdkv2.Client, input *rds_sdkv2.DescribeDBInstancesInput, filter tfslices.Predicate[*types.DBInstance]) ([]types.DBInstance, error) {
+	var output []types.DBInstance
+
+	pages := rds_sdkv2.NewDescribeDBInstancesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.DBInstanceNotFoundFault](err) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.DBInstances {
+			v := v
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+// filterDBInstancesByTags keeps only those instances whose resource tags
+// (fetched via the paginated ListTagsForResource call) match every key/value
+// pair in tagFilter. This runs client-side because DescribeDBInstances has no
+// tag filter of its own.
+func filterDBInstancesByTags(ctx context.Context, conn *rds_sdkv2.Client, instances []types.DBInstance, tagFilter map[string]any) ([]types.DBInstance, error) {
+	var output []types.DBInstance
+
+	for _, instance := range instances {
+		tags, err := listTagsForDBInstance(ctx, conn, aws.ToString(instance.DBInstanceArn))
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if dbInstanceTagsMatch(tags, tagFilter) {
+			output = append(output, instance)
+		}
+	}
+
+	return output, nil
+}
+
+func dbInstanceTagsMatch(tags map[string]string, filter map[string]any) bool {
+	for k, v := range filter {
+		if tags[k] != v.(string) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// listTagsForDBInstance pages through ListTagsForResource and returns the
+// resource's tags as a plain key/value map.
+func listTagsForDBInstance(ctx context.Context, conn *rds_sdkv2.Client, arn string) (map[string]string, error) {
+	output, err := conn.ListTagsForResource(ctx, &rds_sdkv2.ListTagsForResourceInput{
+		ResourceName: aws.String(arn),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make(map[string]string, len(output.TagList))
+	for _, tag := range output.TagList {
+		tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+
+	return tags, nil
+}
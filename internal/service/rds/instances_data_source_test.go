@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExpandDBInstanceFilters(t *testing.T) {
+	t.Parallel()
+
+	filterResource := DataSourceInstances().Schema["filter"].Elem.(*schema.Resource)
+
+	tests := []struct {
+		name string
+		in   []any
+		want []types.Filter
+	}{
+		{
+			name: "no blocks",
+			in:   nil,
+			want: nil,
+		},
+		{
+			name: "one block, one value",
+			in: []any{
+				map[string]any{"name": "engine", "values": []any{"postgres"}},
+			},
+			want: []types.Filter{
+				{Name: aws.String("engine"), Values: []string{"postgres"}},
+			},
+		},
+		{
+			name: "one block, multiple values",
+			in: []any{
+				map[string]any{"name": "db-instance-id", "values": []any{"a", "b"}},
+			},
+			want: []types.Filter{
+				{Name: aws.String("db-instance-id"), Values: []string{"a", "b"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			set := schema.NewSet(schema.HashResource(filterResource), tt.in)
+
+			got := expandDBInstanceFilters(set)
+
+			if tt.want == nil {
+				if len(got) != 0 {
+					t.Fatalf("expandDBInstanceFilters() = %+v, want empty", got)
+				}
+				return
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("expandDBInstanceFilters() = %+v, want %+v", got, tt.want)
+			}
+
+			for _, want := range tt.want {
+				var found bool
+				for _, g := range got {
+					if aws.ToString(g.Name) == aws.ToString(want.Name) && reflect.DeepEqual(g.Values, want.Values) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Errorf("expandDBInstanceFilters() = %+v, missing filter %+v", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestExpandDBInstanceFilters_NilSet(t *testing.T) {
+	t.Parallel()
+
+	if got := expandDBInstanceFilters(nil); got != nil {
+		t.Errorf("expandDBInstanceFilters(nil) = %+v, want nil", got)
+	}
+}
+
+func TestDBInstanceTagsMatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		tags   map[string]string
+		filter map[string]any
+		want   bool
+	}{
+		{
+			name:   "empty filter always matches",
+			tags:   map[string]string{"Environment": "prod"},
+			filter: map[string]any{},
+			want:   true,
+		},
+		{
+			name:   "single matching tag",
+			tags:   map[string]string{"Environment": "prod"},
+			filter: map[string]any{"Environment": "prod"},
+			want:   true,
+		},
+		{
+			name:   "single non-matching value",
+			tags:   map[string]string{"Environment": "staging"},
+			filter: map[string]any{"Environment": "prod"},
+			want:   false,
+		},
+		{
+			name:   "missing tag key",
+			tags:   map[string]string{"Other": "value"},
+			filter: map[string]any{"Environment": "prod"},
+			want:   false,
+		},
+		{
+			name:   "all filter keys must match",
+			tags:   map[string]string{"Environment": "prod", "Team": "rds"},
+			filter: map[string]any{"Environment": "prod", "Team": "other"},
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := dbInstanceTagsMatch(tt.tags, tt.filter); got != tt.want {
+				t.Errorf("dbInstanceTagsMatch(%+v, %+v) = %v, want %v", tt.tags, tt.filter, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// DB instance lifecycle statuses, as returned in DBInstanceStatus. This is
+// not an exhaustive list -- only the values this package's waiters care
+// about -- because AWS does not publish a closed enum for this field.
+const (
+	dbInstanceStatusAvailable         = "available"
+	dbInstanceStatusBackingUp         = "backing-up"
+	dbInstanceStatusConfiguringLogExp = "configuring-log-exports"
+	dbInstanceStatusCreating          = "creating"
+	dbInstanceStatusDeleting          = "deleting"
+	dbInstanceStatusModifying         = "modifying"
+	dbInstanceStatusRestoring         = "restoring"
+	dbInstanceStatusStarting          = "starting"
+	dbInstanceStatusStopping          = "stopping"
+	dbInstanceStatusStopped           = "stopped"
+	dbInstanceStatusUpgrading         = "upgrading"
+)
+
+// statusDBInstance returns a retry.StateRefreshFunc that reports the DB
+// instance's current DBInstanceStatus, or retry.NotFoundError once the
+// instance is gone.
+func statusDBInstance(ctx context.Context, conn *rds_sdkv2.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findDBInstanceByIDSDKv2(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.DBInstanceStatus), nil
+	}
+}
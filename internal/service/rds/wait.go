@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"time"
+
+	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// dbInstanceCreationMode distinguishes the lifecycle path an aws_db_instance
+// apply took, so waitDBInstanceAvailable can report progress in terms the
+// user actually triggered (e.g. "restoring" instead of a generic "creating").
+type dbInstanceCreationMode string
+
+const (
+	dbInstanceCreationModeCreate  dbInstanceCreationMode = "creating"
+	dbInstanceCreationModeRestore dbInstanceCreationMode = "restoring"
+)
+
+// waitDBInstanceAvailable waits for a DB instance to reach the "available"
+// status after a create, restore-from-snapshot, point-in-time-restore, or
+// S3-import creation call. mode only affects the pending state surfaced to
+// the user on timeout; the RDS API reports "creating" for ordinary creates
+// and "restoring" for any of the restore paths.
+func waitDBInstanceAvailable(ctx context.Context, conn *rds_sdkv2.Client, id string, mode dbInstanceCreationMode, timeout time.Duration) (*types.DBInstance, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			string(mode),
+			dbInstanceStatusBackingUp,
+			dbInstanceStatusConfiguringLogExp,
+			dbInstanceStatusModifying,
+			dbInstanceStatusStarting,
+			dbInstanceStatusUpgrading,
+		},
+		Target:     []string{dbInstanceStatusAvailable},
+		Refresh:    statusDBInstance(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.DBInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}